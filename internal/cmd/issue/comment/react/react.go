@@ -0,0 +1,92 @@
+package react
+
+import (
+	"errors"
+	"net/http"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/ankitpokhrel/jira-cli/api"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+const (
+	helpText = `React adds or removes a reaction on a comment.`
+	examples = `$ jira issue comment react ISSUE-1 986745 :thumbsup:
+
+# Remove a previously added reaction
+$ jira issue comment react ISSUE-1 986745 :thumbsup: --remove`
+)
+
+// NewCmdCommentReact is a comment react command.
+func NewCmdCommentReact() *cobra.Command {
+	cmd := cobra.Command{
+		Use:     "react ISSUE-KEY COMMENT-ID REACTION",
+		Short:   "Add or remove a reaction on a comment",
+		Long:    helpText,
+		Example: examples,
+		Annotations: map[string]string{
+			"help:args": "ISSUE-KEY\tIssue key of the source issue, eg: ISSUE-1\n" +
+				"COMMENT-ID\tComment id of the source comment, eg:986745\n" +
+				"REACTION\tEmoji shortcode of the reaction, eg::thumbsup:",
+		},
+		Args: cobra.ExactArgs(3),
+		Run:  react,
+	}
+
+	cmd.Flags().Bool("remove", false, "Remove the reaction instead of adding it")
+
+	return &cmd
+}
+
+func react(cmd *cobra.Command, args []string) {
+	issueKey := cmdutil.GetJiraIssueKey(viper.GetString("project.key"), args[0])
+	commentID := args[1]
+	reaction := args[2]
+
+	remove, err := cmd.Flags().GetBool("remove")
+	cmdutil.ExitIfError(err)
+
+	debug, err := cmd.Flags().GetBool("debug")
+	cmdutil.ExitIfError(err)
+
+	client := api.DefaultClient(debug)
+
+	err = func() error {
+		s := cmdutil.Info("Updating reaction")
+		defer s.Stop()
+
+		return client.SetCommentReaction(issueKey, commentID, reaction, remove)
+	}()
+	if err != nil {
+		cmdutil.PrintError(os.Stderr, wrapAPIError(issueKey, commentID, err), debug)
+		os.Exit(1)
+	}
+
+	if remove {
+		cmdutil.Success("Removed %q from comment %q of issue %q", reaction, commentID, issueKey)
+		return
+	}
+	cmdutil.Success("Added %q to comment %q of issue %q", reaction, commentID, issueKey)
+}
+
+// wrapAPIError turns a raw transport error from SetCommentReaction into a
+// CLIError with a hint for the common, recoverable cases.
+func wrapAPIError(issueKey, commentID string, err error) error {
+	var statusErr *jira.UnexpectedStatusError
+	if !errors.As(err, &statusErr) {
+		return err
+	}
+
+	switch statusErr.StatusCode {
+	case http.StatusNotFound:
+		return cmdutil.CommentNotFoundError(issueKey, commentID, err)
+	case http.StatusForbidden:
+		return cmdutil.PermissionError(err)
+	default:
+		return err
+	}
+}
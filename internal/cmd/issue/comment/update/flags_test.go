@@ -0,0 +1,49 @@
+package update
+
+import (
+	"testing"
+
+	"github.com/spf13/cobra"
+)
+
+func newTestCmd() *cobra.Command {
+	cmd := NewCmdCommentUpdate()
+	cmd.Flags().Bool("debug", false, "")
+	return cmd
+}
+
+func TestParseArgsAndFlagsForceOverridesIfUnchanged(t *testing.T) {
+	cmd := newTestCmd()
+	_ = cmd.Flags().Set("if-unchanged", "true")
+	_ = cmd.Flags().Set("force", "true")
+
+	params := parseArgsAndFlags(nil, cmd.Flags())
+	if params.ifUnchanged {
+		t.Errorf("ifUnchanged = true, want false when --force is also set")
+	}
+	if !params.force {
+		t.Errorf("force = false, want true")
+	}
+}
+
+func TestParseArgsAndFlagsIfUnchangedWithoutForce(t *testing.T) {
+	cmd := newTestCmd()
+	_ = cmd.Flags().Set("if-unchanged", "true")
+
+	params := parseArgsAndFlags(nil, cmd.Flags())
+	if !params.ifUnchanged {
+		t.Errorf("ifUnchanged = false, want true")
+	}
+}
+
+func TestParseArgsAndFlagsAcceptsKnownFormats(t *testing.T) {
+	for _, format := range []string{"adf", "wiki", "md"} {
+		cmd := newTestCmd()
+		_ = cmd.Flags().Set("format", format)
+
+		params := parseArgsAndFlags(nil, cmd.Flags())
+		if params.format != format {
+			t.Errorf("format = %q, want %q", params.format, format)
+		}
+	}
+}
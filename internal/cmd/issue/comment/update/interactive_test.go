@@ -0,0 +1,25 @@
+package update
+
+import "testing"
+
+func TestIsNonInteractive(t *testing.T) {
+	tests := []struct {
+		name   string
+		params addParams
+		want   bool
+	}{
+		{"message flag set", addParams{message: "hi"}, true},
+		{"template flag set", addParams{template: "/tmp/x"}, true},
+		{"no-input flag set", addParams{noInput: true}, true},
+		{"nothing set", addParams{}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			uc := updateCmd{params: &tt.params}
+			if got := uc.isNonInteractive(); got != tt.want {
+				t.Errorf("isNonInteractive() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -1,7 +1,12 @@
 package update
 
 import (
+	"errors"
 	"fmt"
+	"net/http"
+	"os"
+	"strings"
+	"time"
 
 	"github.com/AlecAivazis/survey/v2"
 	"github.com/spf13/cobra"
@@ -14,6 +19,7 @@ import (
 	"github.com/ankitpokhrel/jira-cli/pkg/adf"
 	"github.com/ankitpokhrel/jira-cli/pkg/jira"
 	"github.com/ankitpokhrel/jira-cli/pkg/md"
+	"github.com/ankitpokhrel/jira-cli/pkg/outbox"
 	"github.com/ankitpokhrel/jira-cli/pkg/surveyext"
 )
 
@@ -27,6 +33,9 @@ $ jira issue comment update ISSUE-1 986745 "My comment"
 # Multi-line comment
 $ jira issue comment update ISSUE-1 986745 $'Supports\n\nNew line'
 
+# Pass comment body using the message flag to avoid a positional argument
+$ jira issue comment update ISSUE-1 986745 -m "My comment"
+
 # Load comment body from a template file
 $ jira issue comment update ISSUE-1 986745 --template /path/to/template.tmpl
 
@@ -38,7 +47,16 @@ $ echo "Comment from stdin" | jira issue comment update ISSUE-1 986745
 
 # Positional argument takes precedence over the template flag
 # The example below will add "comment from arg" as a comment
-$ jira issue comment update ISSUE-1 986745 "comment from arg" --template /path/to/template.tmpl`
+$ jira issue comment update ISSUE-1 986745 "comment from arg" --template /path/to/template.tmpl
+
+# Resolve @mentions, :emoji: shortcodes and ![alt](attachment://file) images into native ADF nodes
+$ jira issue comment update ISSUE-1 986745 -m "Thanks @jdoe :tada:" --format adf
+
+# Abort instead of clobbering a newer edit made by someone else
+$ jira issue comment update ISSUE-1 986745 --if-unchanged
+
+# Submit anyway, overwriting any newer server-side edit
+$ jira issue comment update ISSUE-1 986745 -m "My comment" --if-unchanged --force`
 )
 
 // NewCmdCommentAdd is a comment update command.
@@ -57,9 +75,17 @@ func NewCmdCommentUpdate() *cobra.Command {
 	}
 
 	cmd.Flags().Bool("web", false, "Open issue in web browser after adding comment")
+	cmd.Flags().StringP("message", "m", "", "Comment body message")
 	cmd.Flags().StringP("template", "T", "", "Path to a file to read comment body from")
 	cmd.Flags().Bool("no-input", false, "Disable prompt for non-required fields")
 	cmd.Flags().Bool("internal", false, "Make comment internal")
+	cmd.Flags().String("format", "wiki", "Comment body format, one of adf|wiki|md\n"+
+		"adf posts the comment body as a native Atlassian Document Format document,\n"+
+		"resolving @mentions, :emoji: shortcodes and ![alt](attachment://file) images\n"+
+		"into mention, emoji and mediaSingle nodes")
+	cmd.Flags().Bool("offline", false, "Queue the comment in the outbox instead of sending it, eg. when working without network access")
+	cmd.Flags().Bool("if-unchanged", false, "Abort if the comment was modified on the server since it was fetched")
+	cmd.Flags().Bool("force", false, "Skip the --if-unchanged check and submit regardless of server-side changes")
 
 	return &cmd
 }
@@ -97,13 +123,46 @@ func update(cmd *cobra.Command, args []string) {
 		}
 	}
 
+	if uc.params.offline {
+		cmdutil.ExitIfError(uc.queueOffline())
+		return
+	}
+
 	err := func() error {
 		s := cmdutil.Info("Adding comment")
 		defer s.Stop()
 
-		return client.UpdateIssueComment(uc.params.issueKey, uc.params.commentId, uc.params.body, uc.params.internal)
+		var ifUnchangedSince *time.Time
+		if uc.params.ifUnchanged {
+			ifUnchangedSince = uc.sinceUpdated
+		}
+
+		if uc.params.format == "adf" {
+			doc, err := adf.FromExtendedMarkdown(uc.params.body, client.MentionLookup())
+			if err != nil {
+				return err
+			}
+			return client.UpdateIssueCommentADF(uc.params.issueKey, uc.params.commentId, doc, uc.params.internal, ifUnchangedSince)
+		}
+
+		return client.UpdateIssueComment(uc.params.issueKey, uc.params.commentId, uc.params.body, uc.params.internal, ifUnchangedSince)
 	}()
-	cmdutil.ExitIfError(err)
+	if err != nil {
+		if cmdutil.IsNetworkError(err) {
+			cmdutil.ExitIfError(uc.queueOffline())
+			return
+		}
+
+		err = uc.wrapAPIError(err)
+
+		var staleErr *cmdutil.CLIError
+		if errors.As(err, &staleErr) && staleErr.Code == "STALE_COMMENT" {
+			uc.printStaleDiff()
+		}
+
+		cmdutil.PrintError(os.Stderr, err, uc.params.debug)
+		os.Exit(1)
+	}
 
 	server := viper.GetString("server")
 
@@ -117,13 +176,18 @@ func update(cmd *cobra.Command, args []string) {
 }
 
 type addParams struct {
-	issueKey  string
-	commentId string
-	body      string
-	template  string
-	noInput   bool
-	internal  bool
-	debug     bool
+	issueKey    string
+	commentId   string
+	body        string
+	message     string
+	template    string
+	format      string
+	noInput     bool
+	internal    bool
+	offline     bool
+	ifUnchanged bool
+	force       bool
+	debug       bool
 }
 
 func parseArgsAndFlags(args []string, flags query.FlagParser) *addParams {
@@ -143,6 +207,9 @@ func parseArgsAndFlags(args []string, flags query.FlagParser) *addParams {
 	debug, err := flags.GetBool("debug")
 	cmdutil.ExitIfError(err)
 
+	message, err := flags.GetString("message")
+	cmdutil.ExitIfError(err)
+
 	template, err := flags.GetString("template")
 	cmdutil.ExitIfError(err)
 
@@ -152,21 +219,44 @@ func parseArgsAndFlags(args []string, flags query.FlagParser) *addParams {
 	internal, err := flags.GetBool("internal")
 	cmdutil.ExitIfError(err)
 
+	format, err := flags.GetString("format")
+	cmdutil.ExitIfError(err)
+	switch format {
+	case "adf", "wiki", "md":
+	default:
+		cmdutil.Failed("Invalid value %q for --format, must be one of adf|wiki|md", format)
+	}
+
+	offline, err := flags.GetBool("offline")
+	cmdutil.ExitIfError(err)
+
+	ifUnchanged, err := flags.GetBool("if-unchanged")
+	cmdutil.ExitIfError(err)
+
+	force, err := flags.GetBool("force")
+	cmdutil.ExitIfError(err)
+
 	return &addParams{
-		issueKey:  issueKey,
-		commentId: commentId,
-		body:      body,
-		template:  template,
-		noInput:   noInput,
-		internal:  internal,
-		debug:     debug,
+		issueKey:    issueKey,
+		commentId:   commentId,
+		body:        body,
+		message:     message,
+		template:    template,
+		format:      format,
+		noInput:     noInput,
+		internal:    internal,
+		offline:     offline,
+		ifUnchanged: ifUnchanged && !force,
+		force:       force,
+		debug:       debug,
 	}
 }
 
 type updateCmd struct {
-	client    *jira.Client
-	linkTypes []*jira.IssueLinkType
-	params    *addParams
+	client       *jira.Client
+	linkTypes    []*jira.IssueLinkType
+	params       *addParams
+	sinceUpdated *time.Time
 }
 
 func (uc *updateCmd) setIssueKey() error {
@@ -210,10 +300,21 @@ func (uc *updateCmd) setCommentId() error {
 }
 
 func (uc *updateCmd) setBody() error {
+	if uc.params.ifUnchanged {
+		if err := uc.captureBaseline(); err != nil {
+			return err
+		}
+	}
+
 	if uc.params.body != "" {
 		return nil
 	}
 
+	if uc.params.message != "" {
+		uc.params.body = uc.params.message
+		return nil
+	}
+
 	var (
 		qs          []*survey.Question
 		defaultBody string
@@ -236,6 +337,15 @@ func (uc *updateCmd) setBody() error {
 		originalComment, error := uc.client.GetIssueComment(uc.params.issueKey, uc.params.commentId)
 		cmdutil.ExitIfError(error)
 
+		if reactions, err := uc.client.GetCommentReactions(uc.params.issueKey, uc.params.commentId); err == nil && len(reactions) > 0 {
+			printReactionSummary(reactions)
+		}
+
+		if uc.sinceUpdated == nil {
+			updated := originalComment.Updated
+			uc.sinceUpdated = &updated
+		}
+
 		// from internal/view/issue.go:381 how to DRY?
 		var body string
 		if adfNode, ok := originalComment.Body.(*adf.ADF); ok {
@@ -271,6 +381,31 @@ func (uc *updateCmd) setBody() error {
 	return nil
 }
 
+// captureBaseline fetches the comment's current "updated" timestamp so it
+// can later be compared against the server at submit time. It runs
+// unconditionally whenever --if-unchanged is set, independent of how the
+// body itself is supplied (positional arg, -m, -T, stdin, or the editor),
+// since the guard is meaningless without a baseline to compare against.
+func (uc *updateCmd) captureBaseline() error {
+	c, err := uc.client.GetIssueComment(uc.params.issueKey, uc.params.commentId)
+	if err != nil {
+		return fmt.Errorf("could not establish --if-unchanged baseline: %w", err)
+	}
+
+	updated := c.Updated
+	uc.sinceUpdated = &updated
+
+	return nil
+}
+
+func printReactionSummary(reactions []jira.CommentReaction) {
+	parts := make([]string, 0, len(reactions))
+	for _, r := range reactions {
+		parts = append(parts, fmt.Sprintf("%s %d", r.Reaction, r.Count))
+	}
+	fmt.Printf("Reactions: %s\n", strings.Join(parts, "  "))
+}
+
 func getNextAction() *survey.Question {
 	return &survey.Question{
 		Name: "action",
@@ -285,10 +420,79 @@ func getNextAction() *survey.Question {
 	}
 }
 
+// isNonInteractive reports whether the command should skip all prompts,
+// including the "What's next?" survey. This is the case whenever the
+// comment body can be fully resolved without user input: via `-m`, `-T`,
+// `--no-input`, or piped stdin.
 func (uc *updateCmd) isNonInteractive() bool {
-	return cmdutil.StdinHasData() || uc.params.template == "-"
+	return uc.params.message != "" || uc.params.template != "" || uc.params.noInput || cmdutil.StdinHasData()
 }
 
 func (uc *updateCmd) isMandatoryParamsMissing() bool {
 	return uc.params.issueKey == "" || uc.params.commentId == ""
 }
+
+// wrapAPIError turns a raw transport error from UpdateIssueComment into a
+// CLIError with a hint for the common, recoverable cases.
+func (uc *updateCmd) wrapAPIError(err error) error {
+	var statusErr *jira.UnexpectedStatusError
+	if !errors.As(err, &statusErr) {
+		return err
+	}
+
+	switch statusErr.StatusCode {
+	case http.StatusNotFound:
+		return cmdutil.CommentNotFoundError(uc.params.issueKey, uc.params.commentId, err)
+	case http.StatusForbidden:
+		return cmdutil.PermissionError(err)
+	case http.StatusConflict:
+		return cmdutil.StaleCommentError(err)
+	default:
+		return err
+	}
+}
+
+// printStaleDiff shows the server's current comment body next to the local
+// edit that was about to be submitted, so the user can decide whether to
+// re-edit or force the overwrite.
+func (uc *updateCmd) printStaleDiff() {
+	latest, err := uc.client.GetIssueComment(uc.params.issueKey, uc.params.commentId)
+	if err != nil {
+		return
+	}
+
+	var serverBody string
+	if adfNode, ok := latest.Body.(*adf.ADF); ok {
+		serverBody = adf.NewTranslator(adfNode, adf.NewMarkdownTranslator()).Translate()
+	} else {
+		serverBody = md.FromJiraMD(latest.Body.(string))
+	}
+
+	fmt.Println("--- server (current) ---")
+	fmt.Println(serverBody)
+	fmt.Println("--- yours (not submitted) ---")
+	fmt.Println(uc.params.body)
+	fmt.Println("Re-run with --force to overwrite the server version.")
+}
+
+// queueOffline serializes the comment update into the outbox so it can be
+// retried later with `jira outbox flush`.
+func (uc *updateCmd) queueOffline() error {
+	path, err := outbox.Save(&outbox.Entry{
+		Kind:      "comment.update",
+		IssueKey:  uc.params.issueKey,
+		CommentID: uc.params.commentId,
+		Body:      uc.params.body,
+		Internal:  uc.params.internal,
+		Format:    uc.params.format,
+		CreatedAt: time.Now(),
+	})
+	if err != nil {
+		return err
+	}
+
+	cmdutil.Success("Queued comment for issue %q in the outbox", uc.params.issueKey)
+	fmt.Printf("Run `jira outbox flush` to retry, or see %s\n", path)
+
+	return nil
+}
@@ -0,0 +1,96 @@
+package vote
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/ankitpokhrel/jira-cli/api"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+const (
+	helpText = `Vote adds or removes your vote on an issue.`
+	examples = `$ jira issue vote ISSUE-1
+
+# Remove a previously cast vote
+$ jira issue vote ISSUE-1 --remove`
+)
+
+// NewCmdVote is a vote command.
+func NewCmdVote() *cobra.Command {
+	cmd := cobra.Command{
+		Use:     "vote ISSUE-KEY",
+		Short:   "Add or remove your vote on an issue",
+		Long:    helpText,
+		Example: examples,
+		Annotations: map[string]string{
+			"help:args": "ISSUE-KEY\tIssue key, eg: ISSUE-1",
+		},
+		Args: cobra.ExactArgs(1),
+		Run:  vote,
+	}
+
+	cmd.Flags().Bool("remove", false, "Remove your vote instead of adding it")
+
+	return &cmd
+}
+
+func vote(cmd *cobra.Command, args []string) {
+	issueKey := cmdutil.GetJiraIssueKey(viper.GetString("project.key"), args[0])
+
+	remove, err := cmd.Flags().GetBool("remove")
+	cmdutil.ExitIfError(err)
+
+	debug, err := cmd.Flags().GetBool("debug")
+	cmdutil.ExitIfError(err)
+
+	client := api.DefaultClient(debug)
+
+	err = func() error {
+		s := cmdutil.Info("Updating vote")
+		defer s.Stop()
+
+		if remove {
+			return client.RemoveIssueVote(issueKey)
+		}
+		return client.AddIssueVote(issueKey)
+	}()
+	if err != nil {
+		cmdutil.PrintError(os.Stderr, wrapAPIError(issueKey, err), debug)
+		os.Exit(1)
+	}
+
+	if remove {
+		cmdutil.Success("Removed vote from issue %q", issueKey)
+	} else {
+		cmdutil.Success("Voted for issue %q", issueKey)
+	}
+
+	if votes, err := client.GetIssueVotes(issueKey); err == nil {
+		fmt.Printf("Votes: %d\n", votes.Votes)
+	}
+}
+
+// wrapAPIError turns a raw transport error from AddIssueVote/RemoveIssueVote
+// into a CLIError with a hint for the common, recoverable cases.
+func wrapAPIError(issueKey string, err error) error {
+	var statusErr *jira.UnexpectedStatusError
+	if !errors.As(err, &statusErr) {
+		return err
+	}
+
+	switch statusErr.StatusCode {
+	case http.StatusNotFound:
+		return cmdutil.IssueNotFoundError(issueKey, err)
+	case http.StatusForbidden:
+		return cmdutil.PermissionError(err)
+	default:
+		return err
+	}
+}
@@ -0,0 +1,38 @@
+package drop
+
+import (
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
+	"github.com/ankitpokhrel/jira-cli/pkg/outbox"
+)
+
+const (
+	helpText = `Drop discards a queued outbox entry without sending it.`
+	examples = `$ jira outbox drop 1700000000000000000`
+)
+
+// NewCmdOutboxDrop is an outbox drop command.
+func NewCmdOutboxDrop() *cobra.Command {
+	return &cobra.Command{
+		Use:     "drop ENTRY-ID",
+		Short:   "Discard a queued outbox entry",
+		Long:    helpText,
+		Example: examples,
+		Args:    cobra.ExactArgs(1),
+		Run:     drop,
+	}
+}
+
+func drop(_ *cobra.Command, args []string) {
+	if err := outbox.Delete(args[0]); err != nil {
+		if os.IsNotExist(err) {
+			err = cmdutil.OutboxEntryNotFoundError(args[0], err)
+		}
+		cmdutil.PrintError(os.Stderr, err, false)
+		os.Exit(1)
+	}
+	cmdutil.Success("Dropped outbox entry %q", args[0])
+}
@@ -0,0 +1,60 @@
+package edit
+
+import (
+	"os"
+
+	"github.com/AlecAivazis/survey/v2"
+	"github.com/spf13/cobra"
+
+	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
+	"github.com/ankitpokhrel/jira-cli/pkg/outbox"
+	"github.com/ankitpokhrel/jira-cli/pkg/surveyext"
+)
+
+const (
+	helpText = `Edit opens a queued outbox entry's body in your editor before it is flushed.`
+	examples = `$ jira outbox edit 1700000000000000000`
+)
+
+// NewCmdOutboxEdit is an outbox edit command.
+func NewCmdOutboxEdit() *cobra.Command {
+	return &cobra.Command{
+		Use:     "edit ENTRY-ID",
+		Short:   "Edit a queued outbox entry",
+		Long:    helpText,
+		Example: examples,
+		Args:    cobra.ExactArgs(1),
+		Run:     edit,
+	}
+}
+
+func edit(_ *cobra.Command, args []string) {
+	e, err := outbox.Load(args[0])
+	if err != nil {
+		if os.IsNotExist(err) {
+			err = cmdutil.OutboxEntryNotFoundError(args[0], err)
+		}
+		cmdutil.PrintError(os.Stderr, err, false)
+		os.Exit(1)
+	}
+
+	ans := struct{ Body string }{}
+	qs := &survey.Question{
+		Name: "body",
+		Prompt: &surveyext.JiraEditor{
+			Editor: &survey.Editor{
+				Message:       "Comment body",
+				Default:       e.Body,
+				HideDefault:   true,
+				AppendDefault: true,
+			},
+			BlankAllowed: false,
+		},
+	}
+	cmdutil.ExitIfError(survey.Ask([]*survey.Question{qs}, &ans))
+
+	e.Body = ans.Body
+	cmdutil.ExitIfError(outbox.Update(e))
+
+	cmdutil.Success("Updated outbox entry %q", e.ID)
+}
@@ -0,0 +1,100 @@
+package flush
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ankitpokhrel/jira-cli/api"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
+	"github.com/ankitpokhrel/jira-cli/pkg/adf"
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+	"github.com/ankitpokhrel/jira-cli/pkg/outbox"
+)
+
+const (
+	helpText   = `Flush retries all queued outbox entries against the Jira server.`
+	maxRetries = 5
+)
+
+// NewCmdOutboxFlush is an outbox flush command.
+func NewCmdOutboxFlush() *cobra.Command {
+	cmd := cobra.Command{
+		Use:   "flush",
+		Short: "Retry all queued outbox entries",
+		Long:  helpText,
+		Run:   flush,
+	}
+
+	cmd.Flags().Bool("debug", false, "Print debug information")
+
+	return &cmd
+}
+
+func flush(cmd *cobra.Command, _ []string) {
+	debug, err := cmd.Flags().GetBool("debug")
+	cmdutil.ExitIfError(err)
+
+	client := api.DefaultClient(debug)
+
+	entries, err := outbox.List()
+	cmdutil.ExitIfError(err)
+
+	if len(entries) == 0 {
+		cmdutil.Success("Outbox is empty")
+		return
+	}
+
+	for _, e := range entries {
+		if attempts, err := sendWithRetry(client, e); err != nil {
+			e.Attempts += attempts
+			e.LastError = err.Error()
+			cmdutil.ExitIfError(outbox.Update(e))
+
+			fmt.Fprintf(os.Stderr, "Giving up on entry %q after %d attempts:\n", e.ID, e.Attempts)
+			cmdutil.PrintError(os.Stderr, err, debug)
+			continue
+		}
+
+		cmdutil.ExitIfError(outbox.Delete(e.ID))
+		cmdutil.Success("Flushed entry %q (%s %s)", e.ID, e.Kind, e.IssueKey)
+	}
+}
+
+// sendWithRetry retries transient (network/5xx) failures with exponential
+// backoff. A permanent failure - eg. a 404 because the comment was deleted,
+// or a 403 - is returned immediately instead of being retried up to
+// maxRetries times for no chance of success. It also returns the number of
+// send attempts made.
+func sendWithRetry(client *jira.Client, e *outbox.Entry) (int, error) {
+	var lastErr error
+
+	for attempt := 0; attempt < maxRetries; attempt++ {
+		if attempt > 0 {
+			time.Sleep(time.Duration(1<<attempt) * time.Second)
+		}
+
+		lastErr = send(client, e)
+		if lastErr == nil {
+			return attempt + 1, nil
+		}
+		if !cmdutil.IsNetworkError(lastErr) {
+			return attempt + 1, lastErr
+		}
+	}
+
+	return maxRetries, lastErr
+}
+
+func send(client *jira.Client, e *outbox.Entry) error {
+	if e.Format == "adf" {
+		doc, err := adf.FromExtendedMarkdown(e.Body, client.MentionLookup())
+		if err != nil {
+			return err
+		}
+		return client.UpdateIssueCommentADF(e.IssueKey, e.CommentID, doc, e.Internal, nil)
+	}
+	return client.UpdateIssueComment(e.IssueKey, e.CommentID, e.Body, e.Internal, nil)
+}
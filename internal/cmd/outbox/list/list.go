@@ -0,0 +1,36 @@
+package list
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
+	"github.com/ankitpokhrel/jira-cli/pkg/outbox"
+)
+
+const helpText = `List shows comment writes currently queued in the outbox.`
+
+// NewCmdOutboxList is an outbox list command.
+func NewCmdOutboxList() *cobra.Command {
+	return &cobra.Command{
+		Use:   "list",
+		Short: "List queued outbox entries",
+		Long:  helpText,
+		Run:   list,
+	}
+}
+
+func list(*cobra.Command, []string) {
+	entries, err := outbox.List()
+	cmdutil.ExitIfError(err)
+
+	if len(entries) == 0 {
+		fmt.Println("Outbox is empty")
+		return
+	}
+
+	for _, e := range entries {
+		fmt.Printf("%s\t%s\t%s\t%s\n", e.ID, e.Kind, e.IssueKey, e.CreatedAt.Format("2006-01-02 15:04:05"))
+	}
+}
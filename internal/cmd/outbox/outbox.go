@@ -0,0 +1,34 @@
+package outbox
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/ankitpokhrel/jira-cli/internal/cmd/outbox/drop"
+	"github.com/ankitpokhrel/jira-cli/internal/cmd/outbox/edit"
+	"github.com/ankitpokhrel/jira-cli/internal/cmd/outbox/flush"
+	"github.com/ankitpokhrel/jira-cli/internal/cmd/outbox/list"
+)
+
+const helpText = `Outbox manages comment writes that were queued instead of sent,
+either because --offline was passed or because the request failed with a
+network error or a 5xx response.`
+
+// NewCmdOutbox is an outbox command.
+func NewCmdOutbox() *cobra.Command {
+	cmd := cobra.Command{
+		Use:     "outbox",
+		Short:   "Manage queued offline comment writes",
+		Long:    helpText,
+		Aliases: []string{"ob"},
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			return cmd.Help()
+		},
+	}
+
+	cmd.AddCommand(list.NewCmdOutboxList())
+	cmd.AddCommand(flush.NewCmdOutboxFlush())
+	cmd.AddCommand(edit.NewCmdOutboxEdit())
+	cmd.AddCommand(drop.NewCmdOutboxDrop())
+
+	return &cmd
+}
@@ -0,0 +1,108 @@
+package cmdutil
+
+import (
+	"errors"
+	"fmt"
+	"io"
+)
+
+// CLIError is a user-facing error with an actionable hint attached. Wrap API
+// errors in one of these instead of surfacing the raw transport error so the
+// user knows what to do next.
+type CLIError struct {
+	Code       string
+	Msg        string
+	Hint       string
+	HTTPStatus int
+	Cause      error
+}
+
+func (e *CLIError) Error() string {
+	return e.Msg
+}
+
+func (e *CLIError) Unwrap() error {
+	return e.Cause
+}
+
+// CommentNotFoundError wraps a 404 returned while operating on a comment.
+func CommentNotFoundError(issueKey, commentID string, cause error) *CLIError {
+	return &CLIError{
+		Code:       "COMMENT_NOT_FOUND",
+		Msg:        fmt.Sprintf("comment %q not found on issue %q", commentID, issueKey),
+		Hint:       fmt.Sprintf("run `jira issue view %s --comments` to list valid comment ids", issueKey),
+		HTTPStatus: 404,
+		Cause:      cause,
+	}
+}
+
+// PermissionError wraps a 403 returned while operating on an issue or comment.
+func PermissionError(cause error) *CLIError {
+	return &CLIError{
+		Code:       "PERMISSION_DENIED",
+		Msg:        "you don't have permission to perform this action",
+		Hint:       "check that your account has the required project permissions",
+		HTTPStatus: 403,
+		Cause:      cause,
+	}
+}
+
+// IssueNotFoundError wraps a 404 returned while operating on an issue.
+func IssueNotFoundError(issueKey string, cause error) *CLIError {
+	return &CLIError{
+		Code:       "ISSUE_NOT_FOUND",
+		Msg:        fmt.Sprintf("issue %q not found", issueKey),
+		Hint:       "double check the issue key and that you have access to it",
+		HTTPStatus: 404,
+		Cause:      cause,
+	}
+}
+
+// OutboxEntryNotFoundError wraps an error reading or writing an outbox entry
+// that doesn't exist.
+func OutboxEntryNotFoundError(id string, cause error) *CLIError {
+	return &CLIError{
+		Code:  "OUTBOX_ENTRY_NOT_FOUND",
+		Msg:   fmt.Sprintf("outbox entry %q not found", id),
+		Hint:  "run `jira outbox list` to see queued entry ids",
+		Cause: cause,
+	}
+}
+
+// StaleCommentError wraps a 409 returned when a comment changed server-side
+// since it was last fetched.
+func StaleCommentError(cause error) *CLIError {
+	return &CLIError{
+		Code:       "STALE_COMMENT",
+		Msg:        "comment was modified since it was last fetched",
+		Hint:       "re-run the command to see the latest version, or pass --force to overwrite it",
+		HTTPStatus: 409,
+		Cause:      cause,
+	}
+}
+
+// PrintError renders err to w. CLIErrors are printed with their hint;
+// anything else falls back to a plain message. When debug is set, the
+// full cause chain is printed as well.
+func PrintError(w io.Writer, err error, debug bool) {
+	if err == nil {
+		return
+	}
+
+	var cliErr *CLIError
+	if errors.As(err, &cliErr) {
+		fmt.Fprintf(w, "%s %s\n", red("Error:"), cliErr.Msg)
+		if cliErr.Hint != "" {
+			fmt.Fprintf(w, "%s %s\n", yellow("Hint:"), cliErr.Hint)
+		}
+		if debug && cliErr.Cause != nil {
+			fmt.Fprintf(w, "\n%+v\n", cliErr.Cause)
+		}
+		return
+	}
+
+	fmt.Fprintf(w, "%s %s\n", red("Error:"), err.Error())
+	if debug {
+		fmt.Fprintf(w, "\n%+v\n", err)
+	}
+}
@@ -0,0 +1,63 @@
+package cmdutil
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestPrintErrorCLIError(t *testing.T) {
+	err := CommentNotFoundError("ISSUE-1", "986745", errors.New("404"))
+
+	var buf bytes.Buffer
+	PrintError(&buf, err, false)
+
+	out := buf.String()
+	if !strings.Contains(out, err.Msg) {
+		t.Errorf("output = %q, want it to contain message %q", out, err.Msg)
+	}
+	if !strings.Contains(out, err.Hint) {
+		t.Errorf("output = %q, want it to contain hint %q", out, err.Hint)
+	}
+	if strings.Contains(out, "404") {
+		t.Errorf("output = %q, want cause hidden when debug is false", out)
+	}
+}
+
+func TestPrintErrorCLIErrorDebug(t *testing.T) {
+	cause := errors.New("boom")
+	err := PermissionError(cause)
+
+	var buf bytes.Buffer
+	PrintError(&buf, err, true)
+
+	out := buf.String()
+	if !strings.Contains(out, "boom") {
+		t.Errorf("output = %q, want the cause printed when debug is true", out)
+	}
+}
+
+func TestPrintErrorPlainError(t *testing.T) {
+	err := errors.New("something went wrong")
+
+	var buf bytes.Buffer
+	PrintError(&buf, err, false)
+
+	out := buf.String()
+	if !strings.Contains(out, "something went wrong") {
+		t.Errorf("output = %q, want it to contain the error message", out)
+	}
+	if strings.Contains(out, "Hint:") {
+		t.Errorf("output = %q, plain errors should not print a hint", out)
+	}
+}
+
+func TestPrintErrorNil(t *testing.T) {
+	var buf bytes.Buffer
+	PrintError(&buf, nil, false)
+
+	if buf.Len() != 0 {
+		t.Errorf("output = %q, want nothing printed for a nil error", buf.String())
+	}
+}
@@ -0,0 +1,31 @@
+package cmdutil
+
+import (
+	"errors"
+	"net"
+
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+// IsNetworkError reports whether err looks like it was caused by a broken
+// connection rather than a well-formed API response, so the caller can fall
+// back to queueing the write offline instead of failing outright. This
+// covers both transport-level failures (net.Error, eg. DNS/dial/timeout) and
+// 5xx responses from the server.
+func IsNetworkError(err error) bool {
+	if err == nil {
+		return false
+	}
+
+	var netErr net.Error
+	if errors.As(err, &netErr) {
+		return true
+	}
+
+	var statusErr *jira.UnexpectedStatusError
+	if errors.As(err, &statusErr) {
+		return statusErr.StatusCode >= 500
+	}
+
+	return false
+}
@@ -0,0 +1,39 @@
+package cmdutil
+
+import (
+	"errors"
+	"net"
+	"testing"
+
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+type fakeNetError struct{}
+
+func (fakeNetError) Error() string   { return "network unreachable" }
+func (fakeNetError) Timeout() bool   { return true }
+func (fakeNetError) Temporary() bool { return true }
+
+var _ net.Error = fakeNetError{}
+
+func TestIsNetworkError(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{"nil error", nil, false},
+		{"transport error", fakeNetError{}, true},
+		{"5xx status", &jira.UnexpectedStatusError{StatusCode: 503}, true},
+		{"4xx status", &jira.UnexpectedStatusError{StatusCode: 404}, false},
+		{"generic error", errors.New("boom"), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsNetworkError(tt.err); got != tt.want {
+				t.Errorf("IsNetworkError() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
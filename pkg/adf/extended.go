@@ -0,0 +1,178 @@
+package adf
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// UserLookup resolves a free-text query (typically a username) to the
+// matching Jira users; the first match is used for a mention. It is
+// satisfied by (*jira.Client).MentionLookup.
+type UserLookup func(query string) ([]User, error)
+
+// User is the subset of a Jira user profile needed to build a mention node.
+type User struct {
+	AccountID   string
+	DisplayName string
+}
+
+var (
+	mentionPattern = regexp.MustCompile(`@([\w.\-]+)`)
+	emojiPattern   = regexp.MustCompile(`:([a-z0-9_+\-]+):`)
+	mediaPattern   = regexp.MustCompile(`!\[([^\]]*)\]\(attachment://([^)]+)\)`)
+)
+
+// FromExtendedMarkdown parses text into a native ADF document. Blank lines
+// separate paragraphs; other markdown syntax is not interpreted and is kept
+// as plain text. Within a paragraph it recognises:
+//
+//	@username                     -> a mention node, resolved via lookup
+//	:emoji:                       -> an emoji node
+//	![alt](attachment://filename) -> a mediaSingle node referencing an
+//	                                 attachment already uploaded to the issue
+//
+// Any of these that can't be resolved (e.g. an unknown user) are left as
+// plain text rather than failing the whole comment.
+func FromExtendedMarkdown(src string, lookup UserLookup) (*ADF, error) {
+	paragraphs := strings.Split(src, "\n\n")
+	doc := &ADF{Version: 1, Type: "doc", Content: make([]Node, 0, len(paragraphs))}
+
+	for _, p := range paragraphs {
+		if strings.TrimSpace(p) == "" {
+			continue
+		}
+		nodes, err := inlineNodes(p, lookup)
+		if err != nil {
+			return nil, err
+		}
+		doc.Content = append(doc.Content, Node{Type: "paragraph", Content: nodes})
+	}
+
+	return doc, nil
+}
+
+func inlineNodes(text string, lookup UserLookup) ([]Node, error) {
+	var nodes []Node
+
+	for len(text) > 0 {
+		loc := earliestMatch(text)
+		if loc == nil {
+			nodes = append(nodes, textNode(text))
+			break
+		}
+
+		if loc.start > 0 {
+			nodes = append(nodes, textNode(text[:loc.start]))
+		}
+
+		node, err := loc.build(lookup)
+		if err != nil {
+			return nil, err
+		}
+		nodes = append(nodes, node)
+
+		text = text[loc.end:]
+	}
+
+	return nodes, nil
+}
+
+type inlineMatch struct {
+	start, end int
+	build      func(UserLookup) (Node, error)
+}
+
+// earliestMatch finds whichever of mention/emoji/media occurs first in text,
+// so that overlapping candidates (e.g. an emoji shortcode inside alt text)
+// are resolved left-to-right instead of by pattern precedence.
+func earliestMatch(text string) *inlineMatch {
+	var best *inlineMatch
+
+	consider := func(m *inlineMatch) {
+		if m == nil {
+			return
+		}
+		if best == nil || m.start < best.start {
+			best = m
+		}
+	}
+
+	if loc := mediaPattern.FindStringSubmatchIndex(text); loc != nil {
+		alt, filename := text[loc[2]:loc[3]], text[loc[4]:loc[5]]
+		consider(&inlineMatch{
+			start: loc[0],
+			end:   loc[1],
+			build: func(UserLookup) (Node, error) {
+				return mediaSingleNode(alt, filename), nil
+			},
+		})
+	}
+	if loc := mentionPattern.FindStringSubmatchIndex(text); loc != nil {
+		username := text[loc[2]:loc[3]]
+		consider(&inlineMatch{
+			start: loc[0],
+			end:   loc[1],
+			build: func(lookup UserLookup) (Node, error) {
+				return mentionNode(username, lookup)
+			},
+		})
+	}
+	if loc := emojiPattern.FindStringSubmatchIndex(text); loc != nil {
+		shortcode := text[loc[2]:loc[3]]
+		consider(&inlineMatch{
+			start: loc[0],
+			end:   loc[1],
+			build: func(UserLookup) (Node, error) {
+				return emojiNode(shortcode), nil
+			},
+		})
+	}
+
+	return best
+}
+
+func textNode(s string) Node {
+	return Node{Type: "text", Text: s}
+}
+
+func mentionNode(username string, lookup UserLookup) (Node, error) {
+	users, err := lookup(username)
+	if err != nil || len(users) == 0 {
+		return textNode("@" + username), nil
+	}
+	u := users[0]
+	return Node{
+		Type: "mention",
+		Attrs: map[string]interface{}{
+			"id":   u.AccountID,
+			"text": "@" + u.DisplayName,
+		},
+	}, nil
+}
+
+func emojiNode(shortcode string) Node {
+	return Node{
+		Type: "emoji",
+		Attrs: map[string]interface{}{
+			"shortName": fmt.Sprintf(":%s:", shortcode),
+		},
+	}
+}
+
+func mediaSingleNode(alt, filename string) Node {
+	return Node{
+		Type: "mediaSingle",
+		Content: []Node{{
+			Type: "media",
+			Attrs: map[string]interface{}{
+				"type": "file",
+				"alt":  alt,
+				// id is resolved server-side from the attachment filename
+				// already present on the issue; jira-cli does not
+				// re-upload it here.
+				"collection": filename,
+			},
+		}},
+	}
+}
@@ -0,0 +1,76 @@
+package adf
+
+import "testing"
+
+func TestFromExtendedMarkdown(t *testing.T) {
+	lookup := func(query string) ([]User, error) {
+		if query == "jdoe" {
+			return []User{{AccountID: "acc-1", DisplayName: "Jane Doe"}}, nil
+		}
+		return nil, nil
+	}
+
+	doc, err := FromExtendedMarkdown("Thanks @jdoe :tada:", lookup)
+	if err != nil {
+		t.Fatalf("FromExtendedMarkdown() error = %v", err)
+	}
+	if len(doc.Content) != 1 {
+		t.Fatalf("expected 1 paragraph, got %d", len(doc.Content))
+	}
+
+	var gotMention, gotEmoji bool
+	for _, n := range doc.Content[0].Content {
+		switch n.Type {
+		case "mention":
+			gotMention = true
+			if n.Attrs["id"] != "acc-1" {
+				t.Errorf("mention id = %v, want acc-1", n.Attrs["id"])
+			}
+		case "emoji":
+			gotEmoji = true
+			if n.Attrs["shortName"] != ":tada:" {
+				t.Errorf("emoji shortName = %v, want :tada:", n.Attrs["shortName"])
+			}
+		}
+	}
+	if !gotMention || !gotEmoji {
+		t.Errorf("expected both mention and emoji nodes, got mention=%v emoji=%v", gotMention, gotEmoji)
+	}
+}
+
+func TestFromExtendedMarkdownUnknownUserFallsBackToText(t *testing.T) {
+	lookup := func(string) ([]User, error) { return nil, nil }
+
+	doc, err := FromExtendedMarkdown("hi @ghost", lookup)
+	if err != nil {
+		t.Fatalf("FromExtendedMarkdown() error = %v", err)
+	}
+
+	for _, n := range doc.Content[0].Content {
+		if n.Type == "mention" {
+			t.Errorf("expected unresolved @ghost to fall back to plain text, got a mention node")
+		}
+	}
+}
+
+func TestFromExtendedMarkdownMediaSingle(t *testing.T) {
+	lookup := func(string) ([]User, error) { return nil, nil }
+
+	doc, err := FromExtendedMarkdown("see ![a screenshot](attachment://shot.png)", lookup)
+	if err != nil {
+		t.Fatalf("FromExtendedMarkdown() error = %v", err)
+	}
+
+	var media *Node
+	for i, n := range doc.Content[0].Content {
+		if n.Type == "mediaSingle" {
+			media = &doc.Content[0].Content[i]
+		}
+	}
+	if media == nil {
+		t.Fatalf("expected a mediaSingle node, got %+v", doc.Content[0].Content)
+	}
+	if len(media.Content) != 1 || media.Content[0].Attrs["collection"] != "shot.png" {
+		t.Errorf("mediaSingle node = %+v, want a media child referencing shot.png", media)
+	}
+}
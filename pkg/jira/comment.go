@@ -0,0 +1,68 @@
+package jira
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// UpdateIssueComment updates a comment's body on the given issue, posting it
+// as Jira wiki markup.
+//
+// When ifUnchangedSince is non-nil, the comment is re-fetched first and the
+// update is refused with a conflict if its "updated" timestamp has advanced
+// past ifUnchangedSince, so a concurrent edit by someone else isn't silently
+// clobbered. Pass nil to skip the check (eg. when the caller passed --force).
+func (c *Client) UpdateIssueComment(issueKey, commentID, body string, internal bool, ifUnchangedSince *time.Time) error {
+	if ifUnchangedSince != nil {
+		current, err := c.GetIssueComment(issueKey, commentID)
+		if err != nil {
+			return err
+		}
+		if current.Updated.After(*ifUnchangedSince) {
+			return &UnexpectedStatusError{StatusCode: http.StatusConflict, Body: "comment changed since it was fetched"}
+		}
+	}
+
+	payload := struct {
+		Body       string `json:"body"`
+		Properties []struct {
+			Key   string `json:"key"`
+			Value struct {
+				Internal bool `json:"internal"`
+			} `json:"value"`
+		} `json:"properties,omitempty"`
+	}{Body: body}
+
+	if internal {
+		payload.Properties = append(payload.Properties, struct {
+			Key   string `json:"key"`
+			Value struct {
+				Internal bool `json:"internal"`
+			} `json:"value"`
+		}{Key: "sd.public.comment", Value: struct {
+			Internal bool `json:"internal"`
+		}{Internal: internal}})
+	}
+
+	data, err := json.Marshal(&payload)
+	if err != nil {
+		return err
+	}
+
+	res, err := c.PutV2(context.Background(), fmt.Sprintf("/issue/%s/comment/%s", issueKey, commentID), bytes.NewReader(data), Header{
+		"Content-Type": "application/json",
+	})
+	if err != nil {
+		return err
+	}
+	defer func() { _ = res.Body.Close() }()
+
+	if res.StatusCode != http.StatusOK {
+		return formatUnexpectedResponse(res)
+	}
+	return nil
+}
@@ -0,0 +1,71 @@
+package jira
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/ankitpokhrel/jira-cli/pkg/adf"
+)
+
+// UpdateIssueCommentADF updates a comment on the given issue by posting a native
+// ADF document to the v3 comment endpoint instead of translating it to wiki markup.
+// Use this when the comment body contains mention, emoji or mediaSingle nodes that
+// the wiki markup translator would otherwise silently drop.
+//
+// ifUnchangedSince behaves exactly as it does for UpdateIssueComment: when
+// non-nil, the comment is re-fetched first and the update is refused with a
+// conflict if it changed server-side since that timestamp.
+func (c *Client) UpdateIssueCommentADF(issueKey, commentID string, doc *adf.ADF, internal bool, ifUnchangedSince *time.Time) error {
+	if ifUnchangedSince != nil {
+		current, err := c.GetIssueComment(issueKey, commentID)
+		if err != nil {
+			return err
+		}
+		if current.Updated.After(*ifUnchangedSince) {
+			return &UnexpectedStatusError{StatusCode: http.StatusConflict, Body: "comment changed since it was fetched"}
+		}
+	}
+
+	body := struct {
+		Body       *adf.ADF `json:"body"`
+		Properties []struct {
+			Key   string `json:"key"`
+			Value struct {
+				Internal bool `json:"internal"`
+			} `json:"value"`
+		} `json:"properties,omitempty"`
+	}{Body: doc}
+
+	if internal {
+		body.Properties = append(body.Properties, struct {
+			Key   string `json:"key"`
+			Value struct {
+				Internal bool `json:"internal"`
+			} `json:"value"`
+		}{Key: "sd.public.comment", Value: struct {
+			Internal bool `json:"internal"`
+		}{Internal: internal}})
+	}
+
+	data, err := json.Marshal(&body)
+	if err != nil {
+		return err
+	}
+
+	res, err := c.PutV3(context.Background(), fmt.Sprintf("/issue/%s/comment/%s", issueKey, commentID), bytes.NewReader(data), Header{
+		"Content-Type": "application/json",
+	})
+	if err != nil {
+		return err
+	}
+	defer func() { _ = res.Body.Close() }()
+
+	if res.StatusCode != http.StatusOK {
+		return formatUnexpectedResponse(res)
+	}
+	return nil
+}
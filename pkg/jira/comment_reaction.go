@@ -0,0 +1,85 @@
+package jira
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// CommentReaction is a single emoji reaction summary on a comment.
+type CommentReaction struct {
+	Reaction string `json:"reaction"`
+	Count    int    `json:"count"`
+}
+
+// GetCommentReactions fetches the current reaction summary for a comment.
+// Only available on Jira Data Center/Server.
+func (c *Client) GetCommentReactions(issueKey, commentID string) ([]CommentReaction, error) {
+	res, err := c.GetV2(context.Background(), fmt.Sprintf("/issue/%s/comment/%s/reactions", issueKey, commentID), Header{})
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = res.Body.Close() }()
+
+	return decodeCommentReactions(res)
+}
+
+// SetCommentReaction adds or removes an emoji reaction on a comment. This is
+// only available on Jira Data Center/Server; Jira Cloud has no public
+// comment-reaction API, so callers should expect a 404 there.
+func (c *Client) SetCommentReaction(issueKey, commentID, reaction string, remove bool) error {
+	path := fmt.Sprintf("/issue/%s/comment/%s/reactions", issueKey, commentID)
+
+	if remove {
+		res, err := c.DeleteV2(context.Background(), fmt.Sprintf("%s/%s", path, reaction), Header{})
+		if err != nil {
+			return err
+		}
+		defer func() { _ = res.Body.Close() }()
+
+		return checkNoContentResponse(res)
+	}
+
+	data, err := json.Marshal(struct {
+		Reaction string `json:"reaction"`
+	}{Reaction: reaction})
+	if err != nil {
+		return err
+	}
+
+	res, err := c.PostV2(context.Background(), path, bytes.NewReader(data), Header{
+		"Content-Type": "application/json",
+	})
+	if err != nil {
+		return err
+	}
+	defer func() { _ = res.Body.Close() }()
+
+	return checkReactionSetResponse(res)
+}
+
+// decodeCommentReactions decodes a GetCommentReactions response body, or
+// turns a non-200 status into an UnexpectedStatusError. On Jira Cloud this
+// is expected to be a 404, since the reaction API is Data Center/Server only.
+func decodeCommentReactions(res *http.Response) ([]CommentReaction, error) {
+	if res.StatusCode != http.StatusOK {
+		return nil, formatUnexpectedResponse(res)
+	}
+
+	var out []CommentReaction
+	if err := json.NewDecoder(res.Body).Decode(&out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// checkReactionSetResponse turns a status other than 200/201 into an
+// UnexpectedStatusError.
+func checkReactionSetResponse(res *http.Response) error {
+	if res.StatusCode != http.StatusOK && res.StatusCode != http.StatusCreated {
+		return formatUnexpectedResponse(res)
+	}
+	return nil
+}
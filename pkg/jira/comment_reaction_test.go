@@ -0,0 +1,44 @@
+package jira
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+)
+
+func TestDecodeCommentReactions(t *testing.T) {
+	res := newResponse(http.StatusOK, `[{"reaction":":thumbsup:","count":2}]`)
+
+	out, err := decodeCommentReactions(res)
+	if err != nil {
+		t.Fatalf("decodeCommentReactions() error = %v", err)
+	}
+	if len(out) != 1 || out[0].Reaction != ":thumbsup:" || out[0].Count != 2 {
+		t.Errorf("decodeCommentReactions() = %+v, want [{Reaction::thumbsup: Count:2}]", out)
+	}
+}
+
+func TestDecodeCommentReactionsNotFoundOnCloud(t *testing.T) {
+	// Jira Cloud has no public comment-reaction API, so a 404 is expected
+	// there and should still surface as an UnexpectedStatusError.
+	_, err := decodeCommentReactions(newResponse(http.StatusNotFound, "not found"))
+
+	var statusErr *UnexpectedStatusError
+	if !errors.As(err, &statusErr) || statusErr.StatusCode != http.StatusNotFound {
+		t.Errorf("decodeCommentReactions() error = %v, want *UnexpectedStatusError with status 404", err)
+	}
+}
+
+func TestCheckReactionSetResponse(t *testing.T) {
+	for _, status := range []int{http.StatusOK, http.StatusCreated} {
+		if err := checkReactionSetResponse(newResponse(status, "")); err != nil {
+			t.Errorf("checkReactionSetResponse(%d) error = %v, want nil", status, err)
+		}
+	}
+
+	err := checkReactionSetResponse(newResponse(http.StatusNotFound, "not found"))
+	var statusErr *UnexpectedStatusError
+	if !errors.As(err, &statusErr) || statusErr.StatusCode != http.StatusNotFound {
+		t.Errorf("checkReactionSetResponse() error = %v, want *UnexpectedStatusError with status 404", err)
+	}
+}
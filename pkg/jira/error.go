@@ -0,0 +1,23 @@
+package jira
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+)
+
+// UnexpectedStatusError is returned when the server responds with a status
+// code a request didn't explicitly handle.
+type UnexpectedStatusError struct {
+	StatusCode int
+	Body       string
+}
+
+func (e *UnexpectedStatusError) Error() string {
+	return fmt.Sprintf("unexpected response code %d: %s", e.StatusCode, e.Body)
+}
+
+func formatUnexpectedResponse(res *http.Response) error {
+	body, _ := io.ReadAll(res.Body)
+	return &UnexpectedStatusError{StatusCode: res.StatusCode, Body: string(body)}
+}
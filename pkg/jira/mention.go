@@ -0,0 +1,21 @@
+package jira
+
+import "github.com/ankitpokhrel/jira-cli/pkg/adf"
+
+// MentionLookup adapts UserSearch to adf.UserLookup, so callers building an
+// ADF document can resolve @mentions without depending on jira.User
+// themselves.
+func (c *Client) MentionLookup() adf.UserLookup {
+	return func(query string) ([]adf.User, error) {
+		users, err := c.UserSearch(query)
+		if err != nil {
+			return nil, err
+		}
+
+		out := make([]adf.User, 0, len(users))
+		for _, u := range users {
+			out = append(out, adf.User{AccountID: u.AccountID, DisplayName: u.DisplayName})
+		}
+		return out, nil
+	}
+}
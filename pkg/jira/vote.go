@@ -0,0 +1,72 @@
+package jira
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// IssueVotes is the vote summary returned for an issue.
+type IssueVotes struct {
+	Votes    int  `json:"votes"`
+	HasVoted bool `json:"hasVoted"`
+}
+
+// GetIssueVotes fetches the current vote count for an issue, along with
+// whether the authenticated user has already voted for it.
+func (c *Client) GetIssueVotes(issueKey string) (*IssueVotes, error) {
+	res, err := c.GetV2(context.Background(), fmt.Sprintf("/issue/%s/votes", issueKey), Header{})
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = res.Body.Close() }()
+
+	return decodeIssueVotes(res)
+}
+
+// AddIssueVote casts the current user's vote for the given issue.
+func (c *Client) AddIssueVote(issueKey string) error {
+	res, err := c.PostV2(context.Background(), fmt.Sprintf("/issue/%s/votes", issueKey), nil, Header{})
+	if err != nil {
+		return err
+	}
+	defer func() { _ = res.Body.Close() }()
+
+	return checkNoContentResponse(res)
+}
+
+// RemoveIssueVote removes the current user's vote from the given issue.
+func (c *Client) RemoveIssueVote(issueKey string) error {
+	res, err := c.DeleteV2(context.Background(), fmt.Sprintf("/issue/%s/votes", issueKey), Header{})
+	if err != nil {
+		return err
+	}
+	defer func() { _ = res.Body.Close() }()
+
+	return checkNoContentResponse(res)
+}
+
+// decodeIssueVotes decodes a GetIssueVotes response body, or turns a
+// non-200 status into an UnexpectedStatusError.
+func decodeIssueVotes(res *http.Response) (*IssueVotes, error) {
+	if res.StatusCode != http.StatusOK {
+		return nil, formatUnexpectedResponse(res)
+	}
+
+	var out IssueVotes
+	if err := json.NewDecoder(res.Body).Decode(&out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// checkNoContentResponse turns a non-204 status into an
+// UnexpectedStatusError. Used by the vote endpoints, which return no body
+// on success.
+func checkNoContentResponse(res *http.Response) error {
+	if res.StatusCode != http.StatusNoContent {
+		return formatUnexpectedResponse(res)
+	}
+	return nil
+}
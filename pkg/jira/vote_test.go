@@ -0,0 +1,49 @@
+package jira
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func newResponse(statusCode int, body string) *http.Response {
+	return &http.Response{
+		StatusCode: statusCode,
+		Body:       io.NopCloser(strings.NewReader(body)),
+	}
+}
+
+func TestDecodeIssueVotes(t *testing.T) {
+	res := newResponse(http.StatusOK, `{"votes":3,"hasVoted":true}`)
+
+	out, err := decodeIssueVotes(res)
+	if err != nil {
+		t.Fatalf("decodeIssueVotes() error = %v", err)
+	}
+	if out.Votes != 3 || !out.HasVoted {
+		t.Errorf("decodeIssueVotes() = %+v, want {Votes:3 HasVoted:true}", out)
+	}
+}
+
+func TestDecodeIssueVotesUnexpectedStatus(t *testing.T) {
+	_, err := decodeIssueVotes(newResponse(http.StatusNotFound, "issue not found"))
+
+	var statusErr *UnexpectedStatusError
+	if !errors.As(err, &statusErr) || statusErr.StatusCode != http.StatusNotFound {
+		t.Errorf("decodeIssueVotes() error = %v, want *UnexpectedStatusError with status 404", err)
+	}
+}
+
+func TestCheckNoContentResponse(t *testing.T) {
+	if err := checkNoContentResponse(newResponse(http.StatusNoContent, "")); err != nil {
+		t.Errorf("checkNoContentResponse() error = %v, want nil for 204", err)
+	}
+
+	err := checkNoContentResponse(newResponse(http.StatusOK, "unexpected body"))
+	var statusErr *UnexpectedStatusError
+	if !errors.As(err, &statusErr) || statusErr.StatusCode != http.StatusOK {
+		t.Errorf("checkNoContentResponse() error = %v, want *UnexpectedStatusError with status 200", err)
+	}
+}
@@ -0,0 +1,130 @@
+// Package outbox implements a durable local queue for write operations that
+// could not be sent to Jira immediately, so they can be reviewed and
+// retried later (e.g. from a flaky or offline connection).
+package outbox
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+)
+
+// Entry is a single queued write operation.
+type Entry struct {
+	ID        string    `json:"id"`
+	Kind      string    `json:"kind"` // "comment.update"; flush sends every entry as a comment update
+	IssueKey  string    `json:"issueKey"`
+	CommentID string    `json:"commentId,omitempty"`
+	Body      string    `json:"body"`
+	Internal  bool      `json:"internal"`
+	Format    string    `json:"format"`
+	CreatedAt time.Time `json:"createdAt"`
+	Attempts  int       `json:"attempts"`
+	LastError string    `json:"lastError,omitempty"`
+}
+
+// Dir returns the directory entries are stored in, creating it if needed.
+func Dir() (string, error) {
+	base := os.Getenv("XDG_STATE_HOME")
+	if base == "" {
+		home, err := os.UserHomeDir()
+		if err != nil {
+			return "", err
+		}
+		base = filepath.Join(home, ".local", "state")
+	}
+	dir := filepath.Join(base, "jira-cli", "outbox")
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return "", err
+	}
+	return dir, nil
+}
+
+// Save serializes the entry to a new file in the outbox directory and
+// returns the path it was written to. The ID is assigned from the entry's
+// CreatedAt timestamp if not already set, so entries sort chronologically by
+// filename.
+func Save(e *Entry) (string, error) {
+	dir, err := Dir()
+	if err != nil {
+		return "", err
+	}
+
+	if e.ID == "" {
+		e.ID = fmt.Sprintf("%d", e.CreatedAt.UnixNano())
+	}
+
+	path := filepath.Join(dir, e.ID+".json")
+
+	data, err := json.MarshalIndent(e, "", "  ")
+	if err != nil {
+		return "", err
+	}
+	if err := os.WriteFile(path, data, 0o644); err != nil {
+		return "", err
+	}
+	return path, nil
+}
+
+// List returns all queued entries ordered by ID (oldest first).
+func List() ([]*Entry, error) {
+	dir, err := Dir()
+	if err != nil {
+		return nil, err
+	}
+
+	files, err := filepath.Glob(filepath.Join(dir, "*.json"))
+	if err != nil {
+		return nil, err
+	}
+	sort.Strings(files)
+
+	entries := make([]*Entry, 0, len(files))
+	for _, f := range files {
+		data, err := os.ReadFile(f)
+		if err != nil {
+			return nil, err
+		}
+		var e Entry
+		if err := json.Unmarshal(data, &e); err != nil {
+			return nil, err
+		}
+		entries = append(entries, &e)
+	}
+	return entries, nil
+}
+
+// Load reads a single entry by ID.
+func Load(id string) (*Entry, error) {
+	dir, err := Dir()
+	if err != nil {
+		return nil, err
+	}
+	data, err := os.ReadFile(filepath.Join(dir, id+".json"))
+	if err != nil {
+		return nil, err
+	}
+	var e Entry
+	if err := json.Unmarshal(data, &e); err != nil {
+		return nil, err
+	}
+	return &e, nil
+}
+
+// Update rewrites an existing entry in place, e.g. after a failed retry.
+func Update(e *Entry) error {
+	_, err := Save(e)
+	return err
+}
+
+// Delete removes an entry from the outbox.
+func Delete(id string) error {
+	dir, err := Dir()
+	if err != nil {
+		return err
+	}
+	return os.Remove(filepath.Join(dir, id+".json"))
+}
@@ -0,0 +1,55 @@
+package outbox
+
+import (
+	"os"
+	"testing"
+	"time"
+)
+
+func TestSaveListLoadDelete(t *testing.T) {
+	t.Setenv("XDG_STATE_HOME", t.TempDir())
+
+	e := &Entry{
+		Kind:      "comment.update",
+		IssueKey:  "ISSUE-1",
+		CommentID: "123",
+		Body:      "hello",
+		CreatedAt: time.Unix(0, 1),
+	}
+
+	path, err := Save(e)
+	if err != nil {
+		t.Fatalf("Save() error = %v", err)
+	}
+	if _, err := os.Stat(path); err != nil {
+		t.Fatalf("expected entry file at %s: %v", path, err)
+	}
+
+	entries, err := List()
+	if err != nil {
+		t.Fatalf("List() error = %v", err)
+	}
+	if len(entries) != 1 || entries[0].IssueKey != "ISSUE-1" {
+		t.Fatalf("List() = %+v, want a single ISSUE-1 entry", entries)
+	}
+
+	loaded, err := Load(e.ID)
+	if err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+	if loaded.Body != "hello" {
+		t.Fatalf("Load().Body = %q, want %q", loaded.Body, "hello")
+	}
+
+	if err := Delete(e.ID); err != nil {
+		t.Fatalf("Delete() error = %v", err)
+	}
+
+	entries, err = List()
+	if err != nil {
+		t.Fatalf("List() error = %v", err)
+	}
+	if len(entries) != 0 {
+		t.Fatalf("expected outbox to be empty after Delete, got %+v", entries)
+	}
+}